@@ -1,8 +1,10 @@
 package containers
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 	"text/template"
 
@@ -12,10 +14,14 @@ import (
 	"github.com/containers/podman/v3/cmd/podman/utils"
 	"github.com/containers/podman/v3/cmd/podman/validate"
 	"github.com/containers/podman/v3/pkg/domain/entities"
+	"github.com/containers/podman/v3/pkg/util"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 )
 
+// propagationModes are the mount propagation modes accepted by --propagation.
+var propagationModes = []string{"shared", "slave", "private", "rshared", "rslave", "rprivate"}
+
 var (
 	mountDescription = `podman mount
     Lists all mounted containers mount points if no container is specified
@@ -64,6 +70,14 @@ func mountFlags(cmd *cobra.Command) {
 	_ = cmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteJSONFormat)
 
 	flags.BoolVar(&mountOpts.NoTruncate, "notruncate", false, "Do not truncate output")
+
+	flags.BoolVar(&mountOpts.ReadOnly, "read-only", false, "Mount the container's root filesystem read-only")
+
+	flags.StringVar(&mountOpts.Propagation, "propagation", "", "Mount propagation for the container's root filesystem ("+strings.Join(propagationModes, "|")+")")
+
+	flags.StringVar(&mountOpts.Target, "target", "", "Bind-mount the container's root filesystem at the given path instead of the storage driver's mountpoint")
+
+	flags.BoolVar(&mountOpts.Watch, "watch", false, "Stream a JSON-lines feed of currently mounted containers plus subsequent mount/unmount events until interrupted")
 }
 
 func init() {
@@ -87,6 +101,23 @@ func mount(_ *cobra.Command, args []string) error {
 	if len(args) > 0 && mountOpts.Latest {
 		return errors.Errorf("--latest and containers cannot be used together")
 	}
+	if mountOpts.Propagation != "" && !util.StringInSlice(mountOpts.Propagation, propagationModes) {
+		return errors.Errorf("invalid --propagation value %q: must be one of %s", mountOpts.Propagation, strings.Join(propagationModes, ", "))
+	}
+	if mountOpts.Target != "" {
+		if mountOpts.All {
+			return errors.Errorf("--target and --all cannot be used together")
+		}
+		if len(args) > 1 {
+			return errors.Errorf("--target can only be used with a single container")
+		}
+	}
+	if mountOpts.Watch {
+		if len(args) > 0 || mountOpts.All || mountOpts.Latest || mountOpts.Target != "" {
+			return errors.Errorf("--watch cannot be used with containers, --all, --latest, or --target")
+		}
+		return watchMounts()
+	}
 	reports, err := registry.ContainerEngine().ContainerMount(registry.GetContext(), args, mountOpts)
 	if err != nil {
 		return err
@@ -96,7 +127,11 @@ func mount(_ *cobra.Command, args []string) error {
 		var errs utils.OutputErrors
 		for _, r := range reports {
 			if r.Err == nil {
-				fmt.Println(r.Path)
+				if r.Target != "" {
+					fmt.Println(r.Target)
+				} else {
+					fmt.Println(r.Path)
+				}
 				continue
 			}
 			errs = append(errs, r.Err)
@@ -128,11 +163,31 @@ func mount(_ *cobra.Command, args []string) error {
 	return tmpl.Execute(w, mrs)
 }
 
+// watchMounts prints the currently mounted containers followed by
+// subsequent mount/unmount events as a JSON-lines stream until interrupted.
+func watchMounts() error {
+	reports := make(chan entities.ContainerMountEvent)
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- registry.ContainerEngine().ContainerMountEvents(registry.GetContext(), reports)
+		close(reports)
+	}()
+
+	enc := json.NewEncoder(os.Stdout)
+	for r := range reports {
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	return <-errChan
+}
+
 func printJSON(reports []*entities.ContainerMountReport) error {
 	type jreport struct {
 		ID         string `json:"id"`
 		Names      []string
 		Mountpoint string `json:"mountpoint"`
+		Target     string `json:"target,omitempty"`
 	}
 	jreports := make([]jreport, 0, len(reports))
 
@@ -141,6 +196,7 @@ func printJSON(reports []*entities.ContainerMountReport) error {
 			ID:         r.Id,
 			Names:      []string{r.Name},
 			Mountpoint: r.Path,
+			Target:     r.Target,
 		})
 	}
 	b, err := json.MarshalIndent(jreports, "", " ")
@@ -162,3 +218,12 @@ func (m mountReporter) ID() string {
 	}
 	return m.Id[0:12]
 }
+
+// Path shadows the embedded report's Path field so --target mounts display
+// the caller-supplied path instead of the storage driver's mountpoint.
+func (m mountReporter) Path() string {
+	if m.Target != "" {
+		return m.Target
+	}
+	return m.ContainerMountReport.Path
+}
@@ -0,0 +1,94 @@
+package containers
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v3/cmd/podman/common"
+	"github.com/containers/podman/v3/cmd/podman/registry"
+	"github.com/containers/podman/v3/cmd/podman/utils"
+	"github.com/containers/podman/v3/cmd/podman/validate"
+	"github.com/containers/podman/v3/pkg/domain/entities"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+var (
+	umountDescription = `podman umount
+    Unmounts the specified container's root file system.
+
+  podman umount --all
+    Unmounts the root file system of all currently mounted containers, including any --target bind mount recorded for them.
+`
+
+	umountCommand = &cobra.Command{
+		Use:     "umount [options] CONTAINER [CONTAINER...]",
+		Aliases: []string{"unmount"},
+		Short:   "Unmount working container's root filesystem",
+		Long:    umountDescription,
+		RunE:    umount,
+		Args: func(cmd *cobra.Command, args []string) error {
+			return validate.CheckAllLatestAndCIDFile(cmd, args, false, false)
+		},
+		Annotations: map[string]string{
+			registry.UnshareNSRequired: "",
+			registry.ParentNSRequired:  "",
+		},
+		ValidArgsFunction: common.AutocompleteContainers,
+	}
+
+	containerUmountCommand = &cobra.Command{
+		Use:               umountCommand.Use,
+		Aliases:           umountCommand.Aliases,
+		Short:             umountCommand.Short,
+		Long:              umountCommand.Long,
+		RunE:              umountCommand.RunE,
+		Args:              umountCommand.Args,
+		Annotations:       umountCommand.Annotations,
+		ValidArgsFunction: umountCommand.ValidArgsFunction,
+	}
+)
+
+var umountOpts entities.ContainerUnmountOptions
+
+func umountFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+
+	flags.BoolVarP(&umountOpts.All, "all", "a", false, "Unmount all of the currently mounted containers")
+	flags.BoolVarP(&umountOpts.Force, "force", "f", false, "Force the complete unmount of the specified containers")
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode},
+		Command: umountCommand,
+	})
+	umountFlags(umountCommand)
+	validate.AddLatestFlag(umountCommand, &umountOpts.Latest)
+
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Mode:    []entities.EngineMode{entities.ABIMode},
+		Command: containerUmountCommand,
+		Parent:  containerCmd,
+	})
+	umountFlags(containerUmountCommand)
+	validate.AddLatestFlag(containerUmountCommand, &umountOpts.Latest)
+}
+
+func umount(_ *cobra.Command, args []string) error {
+	if len(args) > 0 && umountOpts.Latest {
+		return errors.Errorf("--latest and containers cannot be used together")
+	}
+	reports, err := registry.ContainerEngine().ContainerUnmount(registry.GetContext(), args, umountOpts)
+	if err != nil {
+		return err
+	}
+	var errs utils.OutputErrors
+	for _, r := range reports {
+		if r.Err == nil {
+			fmt.Println(r.Id)
+			continue
+		}
+		errs = append(errs, r.Err)
+	}
+	return errs.PrintErrors()
+}
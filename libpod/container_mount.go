@@ -0,0 +1,99 @@
+package libpod
+
+import (
+	"strconv"
+
+	"github.com/containers/podman/v3/libpod/events"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// MountWithOptions mounts a container's root filesystem, honoring opts
+// instead of always taking a read-write bind at the storage driver's
+// default propagation. On success it records a mount event carrying the
+// resolved mountpoint and the container's current mount refcount so
+// `podman mount --watch` can report it without polling.
+func (c *Container) MountWithOptions(opts ContainerMountOptions) (string, error) {
+	path, err := c.mount()
+	if err != nil {
+		return "", err
+	}
+
+	if opts.ReadOnly {
+		if err := unix.Mount("", path, "", unix.MS_BIND|unix.MS_REMOUNT|unix.MS_RDONLY, ""); err != nil {
+			return "", errors.Wrapf(err, "remounting %s read-only", path)
+		}
+	}
+
+	if opts.Propagation != "" {
+		flag, err := propagationFlag(opts.Propagation)
+		if err != nil {
+			return "", err
+		}
+		if err := unix.Mount("", path, "", flag, ""); err != nil {
+			return "", errors.Wrapf(err, "setting %s propagation on %s", opts.Propagation, path)
+		}
+	}
+
+	c.newContainerEvent(events.Mount, map[string]string{
+		"mountpoint": path,
+		"refCount":   strconv.Itoa(c.state.MountCount),
+	})
+	return path, nil
+}
+
+// propagationFlag maps a --propagation value to the mount(2) flag that
+// applies it. Validated up front in the CLI, so an unrecognized value here
+// indicates a caller bypassing that validation.
+func propagationFlag(propagation string) (uintptr, error) {
+	switch propagation {
+	case "shared":
+		return unix.MS_SHARED, nil
+	case "slave":
+		return unix.MS_SLAVE, nil
+	case "private":
+		return unix.MS_PRIVATE, nil
+	case "rshared":
+		return unix.MS_REC | unix.MS_SHARED, nil
+	case "rslave":
+		return unix.MS_REC | unix.MS_SLAVE, nil
+	case "rprivate":
+		return unix.MS_REC | unix.MS_PRIVATE, nil
+	default:
+		return 0, errors.Errorf("unknown mount propagation %q", propagation)
+	}
+}
+
+// Unmount unmounts a container's root filesystem, forcing the unmount past
+// a refcount of one when force is set. On success it records an unmount
+// event carrying the container's remaining mount refcount.
+func (c *Container) Unmount(force bool) error {
+	if err := c.unmount(force); err != nil {
+		return err
+	}
+	c.newContainerEvent(events.Unmount, map[string]string{
+		"refCount": strconv.Itoa(c.state.MountCount),
+	})
+	return nil
+}
+
+// MountCount returns how many callers currently hold the container's rootfs
+// mounted.
+func (c *Container) MountCount() int {
+	return c.state.MountCount
+}
+
+// newContainerEvent publishes a container event carrying the given
+// attributes in addition to the container's ID and name.
+func (c *Container) newContainerEvent(status events.Status, attributes map[string]string) {
+	if err := c.runtime.eventer.Write(&events.Event{
+		ID:         c.ID(),
+		Name:       c.Name(),
+		Status:     status,
+		Type:       events.Container,
+		Attributes: attributes,
+	}); err != nil {
+		logrus.Errorf("Unable to write %s event for container %s: %v", status, c.ID(), err)
+	}
+}
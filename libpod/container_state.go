@@ -0,0 +1,16 @@
+package libpod
+
+// ContainerState holds the runtime state of a container that is persisted
+// to the database between invocations of podman, since each podman command
+// is a separate process.
+type ContainerState struct {
+	// MountCount is the number of times the container's rootfs has been
+	// mounted without a matching unmount; the rootfs is only actually
+	// unmounted once it drops back to zero.
+	MountCount int
+	// MountTarget is the path the rootfs was bind-mounted to by
+	// `podman mount --target`, so a later `podman umount` (a separate
+	// process) knows where to tear the bind down. Empty when the rootfs
+	// was mounted at the storage driver's own mountpoint.
+	MountTarget string
+}
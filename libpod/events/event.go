@@ -0,0 +1,37 @@
+package events
+
+// Type indicates what general category an event belongs to (container, pod,
+// volume, etc).
+type Type string
+
+// Status indicates the specific action recorded by an Event.
+type Status string
+
+const (
+	// Container is the event type for events about containers.
+	Container Type = "container"
+
+	// Mount indicates a container's root filesystem was mounted at the
+	// storage driver's mountpoint. A later bind of the rootfs at a
+	// caller-specified target is not recorded as an event of its own.
+	Mount Status = "mount"
+	// Unmount indicates a container's root filesystem was unmounted.
+	Unmount Status = "unmount"
+)
+
+// Event describes a single occurrence recorded by the events subsystem.
+type Event struct {
+	// ID is the ID of the object (e.g. container ID) the event is about.
+	ID string
+	// Name is the name of the object the event is about.
+	Name string
+	// Status is the specific action that occurred.
+	Status Status
+	// Type is the general category of object the event is about.
+	Type Type
+	// Time is when the event was recorded, formatted per time.RFC3339Nano.
+	Time string
+	// Attributes carries event-specific details that don't warrant a
+	// dedicated field, such as a mount's resolved path and refcount.
+	Attributes map[string]string
+}
@@ -0,0 +1,13 @@
+package events
+
+import "context"
+
+// Eventer is implemented by the backends (journald, file-based log, …) that
+// the runtime can record events through.
+type Eventer interface {
+	// Write records a single event.
+	Write(*Event) error
+	// Stream sends every recorded event matching no filter to channel
+	// until ctx is cancelled.
+	Stream(ctx context.Context, channel chan *Event) error
+}
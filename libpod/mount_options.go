@@ -0,0 +1,30 @@
+package libpod
+
+// ContainerMountOptions alter how a container's rootfs is mounted by
+// MountWithOptions. The zero value preserves the previous behavior of Mount:
+// a read-write bind using the storage driver's default propagation.
+type ContainerMountOptions struct {
+	// ReadOnly mounts the rootfs read-only instead of read-write.
+	ReadOnly bool
+	// Propagation overrides the bind mount propagation mode used for the
+	// rootfs. Must be one of "shared", "slave", "private", "rshared",
+	// "rslave", or "rprivate". An empty string keeps the storage driver's
+	// default propagation.
+	Propagation string
+}
+
+// SetMountTarget records the path that the container's rootfs has been
+// bind-mounted to by `podman mount --target`, persisting it in container
+// state so a later `podman umount` invocation (a separate process) can find
+// and tear down the bind. Pass an empty string to clear it.
+func (c *Container) SetMountTarget(target string) error {
+	c.state.MountTarget = target
+	return c.save()
+}
+
+// MountTarget returns the path most recently recorded by SetMountTarget, or
+// the empty string if the container's rootfs was not bind-mounted at a
+// caller-specified target.
+func (c *Container) MountTarget() string {
+	return c.state.MountTarget
+}
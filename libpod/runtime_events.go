@@ -0,0 +1,14 @@
+package libpod
+
+import (
+	"context"
+
+	"github.com/containers/podman/v3/libpod/events"
+)
+
+// Events sends each event recorded by the runtime's eventer to eventChannel
+// until ctx is cancelled. Callers are expected to run it in its own
+// goroutine and close down when ctx is done.
+func (r *Runtime) Events(ctx context.Context, eventChannel chan *events.Event) error {
+	return r.eventer.Stream(ctx, eventChannel)
+}
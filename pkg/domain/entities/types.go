@@ -0,0 +1,49 @@
+package entities
+
+// ContainerMountOptions describes the input values for mounting containers
+// in the CLI
+type ContainerMountOptions struct {
+	All         bool
+	Format      string
+	Latest      bool
+	NoTruncate  bool
+	ReadOnly    bool
+	Propagation string
+	Target      string
+	Watch       bool
+}
+
+// ContainerMountReport describes the response from container mount
+type ContainerMountReport struct {
+	Err  error
+	Id   string
+	Name string
+	Path string
+	// Target is the caller-supplied path the rootfs was bind-mounted at,
+	// set only when --target was used. Empty otherwise.
+	Target string
+}
+
+// ContainerMountEvent describes a single JSON-lines entry emitted by
+// `podman mount --watch`: either a currently mounted container (Status
+// "mount" emitted at startup) or a later mount/unmount event.
+type ContainerMountEvent struct {
+	Id         string `json:"id"`
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Mountpoint string `json:"mountpoint,omitempty"`
+	RefCount   int    `json:"refCount"`
+}
+
+// ContainerUnmountOptions describes the input values for umount command
+type ContainerUnmountOptions struct {
+	All    bool
+	Force  bool
+	Latest bool
+}
+
+// ContainerUnmountReport describes the response from umount
+type ContainerUnmountReport struct {
+	Err error
+	Id  string
+}
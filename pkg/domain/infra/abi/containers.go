@@ -0,0 +1,145 @@
+package abi
+
+import (
+	"context"
+	"os"
+
+	"github.com/containers/podman/v3/libpod"
+	"github.com/containers/podman/v3/pkg/domain/entities"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// ContainerMount mounts the rootfs of the given containers (or all
+// containers with --all) and returns the resulting mountpoints. When no
+// container is named and neither --all nor --latest is set, it mounts
+// nothing and reports the containers that are already mounted.
+func (ic *ContainerEngine) ContainerMount(ctx context.Context, nameOrIDs []string, options entities.ContainerMountOptions) ([]*entities.ContainerMountReport, error) {
+	listOnly := len(nameOrIDs) == 0 && !options.All && !options.Latest
+	var ctrs []*libpod.Container
+	var err error
+	if listOnly {
+		ctrs, err = ic.Libpod.GetAllContainers()
+	} else {
+		ctrs, err = getContainersByContext(options.All, options.Latest, nameOrIDs, ic.Libpod)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	mountOpts := libpod.ContainerMountOptions{
+		ReadOnly:    options.ReadOnly,
+		Propagation: options.Propagation,
+	}
+
+	reports := []*entities.ContainerMountReport{}
+	for _, ctr := range ctrs {
+		mounted, path, err := ctr.Mounted()
+		if err != nil {
+			return nil, err
+		}
+
+		if !mounted {
+			if listOnly {
+				continue
+			}
+			path, err = ctr.MountWithOptions(mountOpts)
+		}
+
+		report := &entities.ContainerMountReport{
+			Err:  err,
+			Id:   ctr.ID(),
+			Name: ctr.Name(),
+			Path: path,
+		}
+		if listOnly {
+			report.Target = ctr.MountTarget()
+		} else if err == nil && options.Target != "" {
+			if report.Target, err = bindMountAt(ctr, path, options.Target); err != nil {
+				report.Err = err
+			}
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// ContainerUnmount unmounts the rootfs of the given containers (or all
+// currently mounted containers when no names are supplied), tearing down
+// any --target bind mount recorded for them.
+func (ic *ContainerEngine) ContainerUnmount(ctx context.Context, nameOrIDs []string, options entities.ContainerUnmountOptions) ([]*entities.ContainerUnmountReport, error) {
+	ctrs, err := getContainersByContext(options.All, options.Latest, nameOrIDs, ic.Libpod)
+	if err != nil {
+		return nil, err
+	}
+	reports := []*entities.ContainerUnmountReport{}
+	for _, ctr := range ctrs {
+		state, err := ctr.State()
+		if err != nil {
+			reports = append(reports, &entities.ContainerUnmountReport{Id: ctr.ID(), Err: err})
+			continue
+		}
+		if state == libpod.ContainerStateRunning {
+			reports = append(reports, &entities.ContainerUnmountReport{
+				Id:  ctr.ID(),
+				Err: errors.Errorf("can't unmount container %s as it is running", ctr.ID()),
+			})
+			continue
+		}
+
+		report := &entities.ContainerUnmountReport{Id: ctr.ID()}
+		if target := ctr.MountTarget(); target != "" {
+			// unmountBindAt lazily (MNT_DETACH) tears down the bind when
+			// --force is set, so a busy target can't block it. Any failure,
+			// forced or not, must stop us from clearing the recorded target
+			// below — otherwise podman forgets about a bind that is still
+			// live on disk.
+			if err := unmountBindAt(target, options.Force); err != nil {
+				report.Err = err
+				reports = append(reports, report)
+				continue
+			}
+			if err := ctr.SetMountTarget(""); err != nil {
+				report.Err = err
+				reports = append(reports, report)
+				continue
+			}
+		}
+		if report.Err = ctr.Unmount(options.Force); report.Err != nil {
+			logrus.Errorf("Error unmounting container %s: %v", ctr.ID(), report.Err)
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// bindMountAt bind-mounts the already-mounted rootfs at src onto target,
+// creating target if it does not already exist, and records target on the
+// container so umount can find and tear it down.
+func bindMountAt(ctr *libpod.Container, src, target string) (string, error) {
+	if err := os.MkdirAll(target, 0o755); err != nil {
+		return "", errors.Wrapf(err, "creating mount target %s", target)
+	}
+	if err := unix.Mount(src, target, "", unix.MS_BIND, ""); err != nil {
+		return "", errors.Wrapf(err, "bind mounting %s to %s", src, target)
+	}
+	if err := ctr.SetMountTarget(target); err != nil {
+		_ = unmountBindAt(target, true)
+		return "", err
+	}
+	return target, nil
+}
+
+// unmountBindAt reverses bindMountAt. When force is set it lazily unmounts
+// (MNT_DETACH) so a target that is still busy doesn't block teardown.
+func unmountBindAt(target string, force bool) error {
+	var flags int
+	if force {
+		flags = unix.MNT_DETACH
+	}
+	if err := unix.Unmount(target, flags); err != nil {
+		return errors.Wrapf(err, "unmounting %s", target)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package abi
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/containers/podman/v3/libpod/events"
+	"github.com/containers/podman/v3/pkg/domain/entities"
+)
+
+// ContainerMountEvents streams currently mounted containers followed by
+// subsequent mount/unmount events to reports, until ctx is cancelled. It
+// backs `podman mount --watch`.
+func (ic *ContainerEngine) ContainerMountEvents(ctx context.Context, reports chan<- entities.ContainerMountEvent) error {
+	// Subscribe before taking the snapshot below, so no window exists in
+	// which a mount/unmount can happen and never show up on eventChannel.
+	// The channel is buffered so the subscriber goroutine can queue up to
+	// 64 events while we walk the container list before it blocks on us.
+	eventChannel := make(chan *events.Event, 64)
+	errChannel := make(chan error, 1)
+	go func() {
+		errChannel <- ic.Libpod.Events(ctx, eventChannel)
+		close(eventChannel)
+	}()
+
+	ctrs, err := ic.Libpod.GetAllContainers()
+	if err != nil {
+		return err
+	}
+	for _, ctr := range ctrs {
+		mounted, path, err := ctr.Mounted()
+		if err != nil {
+			return err
+		}
+		if !mounted {
+			continue
+		}
+		reports <- entities.ContainerMountEvent{
+			Id:         ctr.ID(),
+			Name:       ctr.Name(),
+			Status:     "mount",
+			Mountpoint: path,
+			RefCount:   ctr.MountCount(),
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-eventChannel:
+			if !ok {
+				return <-errChannel
+			}
+			if event.Type != events.Container || (event.Status != events.Mount && event.Status != events.Unmount) {
+				continue
+			}
+			refCount, _ := strconv.Atoi(event.Attributes["refCount"])
+			reports <- entities.ContainerMountEvent{
+				Id:         event.ID,
+				Name:       event.Name,
+				Status:     string(event.Status),
+				Mountpoint: event.Attributes["mountpoint"],
+				RefCount:   refCount,
+			}
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package tunnel
+
+import (
+	"context"
+
+	"github.com/containers/podman/v3/pkg/domain/entities"
+	"github.com/pkg/errors"
+)
+
+// ContainerMount is not supported on remote clients: the rootfs lives on the
+// host running the Podman service, not the machine issuing the command.
+func (ic *ContainerEngine) ContainerMount(_ context.Context, _ []string, _ entities.ContainerMountOptions) ([]*entities.ContainerMountReport, error) {
+	return nil, errors.New("mounting containers is not supported for remote clients")
+}
+
+// ContainerUnmount is not supported on remote clients for the same reason as
+// ContainerMount: the rootfs lives on the host running the Podman service.
+func (ic *ContainerEngine) ContainerUnmount(_ context.Context, _ []string, _ entities.ContainerUnmountOptions) ([]*entities.ContainerUnmountReport, error) {
+	return nil, errors.New("unmounting containers is not supported for remote clients")
+}
+
+// ContainerMountEvents is not supported on remote clients; see ContainerMount.
+func (ic *ContainerEngine) ContainerMountEvents(_ context.Context, _ chan<- entities.ContainerMountEvent) error {
+	return errors.New("watching container mounts is not supported for remote clients")
+}